@@ -0,0 +1,34 @@
+// Copyright 2018 Drone.IO Inc
+// Use of this software is governed by the Business Source License
+// that can be found in the LICENSE file.
+
+// Package autoscaler provides the core types shared by the hosting
+// provider drivers, the server store and the scaling loop.
+package autoscaler
+
+import "context"
+
+// Server represents a managed build agent instance.
+type Server struct {
+	ID      string `json:"id"      db:"server_id"`
+	Name    string `json:"name"    db:"server_name"`
+	Address string `json:"address" db:"server_address"`
+	Region  string `json:"region"  db:"server_region"`
+	Image   string `json:"image"   db:"server_image"`
+	Size    string `json:"size"    db:"server_size"`
+	Created int64  `json:"created" db:"server_created"`
+	Paused  bool   `json:"paused"  db:"server_paused"`
+}
+
+// Provider defines a hosting provider capable of creating and
+// destroying server instances.
+type Provider interface {
+	// Create creates the server instance.
+	Create(ctx context.Context, server Server) (Server, error)
+
+	// Destroy terminates the server instance.
+	Destroy(ctx context.Context, server Server) error
+
+	// List returns the list of running server instances.
+	List(ctx context.Context) ([]Server, error)
+}
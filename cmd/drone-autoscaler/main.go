@@ -7,13 +7,16 @@ package main
 import (
 	"context"
 	"errors"
+	"net"
 	"net/http"
 	"net/url"
 	"os"
 
 	"github.com/drone/autoscaler"
 	"github.com/drone/autoscaler/config"
+	"github.com/drone/autoscaler/drivers/amazon"
 	"github.com/drone/autoscaler/drivers/digitalocean"
+	"github.com/drone/autoscaler/drivers/hetzner"
 	"github.com/drone/autoscaler/metrics"
 	"github.com/drone/autoscaler/scaler"
 	"github.com/drone/autoscaler/server"
@@ -61,12 +64,19 @@ func main() {
 		provider = slack.New(conf, provider)
 	}
 
-	db := store.Must(conf.Database.Path)
-	servers := store.NewServerStore(db)
+	servers, samples, closer, err := setupStore(conf)
+	if err != nil {
+		log.Fatal().Err(err).
+			Msg("Invalid or missing database configuration")
+	}
+	defer closer()
+
+	if conf.Pool.Predictive && samples == nil {
+		log.Fatal().Msg("Predictive scaling requires the bolt database backend")
+	}
 
 	// instruments the store with prometheus metrics.
 	servers = metrics.ServerCount(servers)
-	defer db.Close()
 
 	client := setupClient(conf)
 
@@ -80,6 +90,7 @@ func main() {
 	r.Get("/metrics", server.HandleMetrics(conf.Prometheus.Token))
 	r.Get("/version", server.HandleVersion(source, version, commit))
 	r.Get("/healthz", server.HandleHealthz())
+	r.Post("/slack/command", server.HandleSlackCommand(servers, provider, conf))
 	r.Route("/api", func(r chi.Router) {
 		r.Use(server.CheckDrone(conf))
 
@@ -119,6 +130,41 @@ func main() {
 		return srv.ListenAndServe()
 	})
 
+	//
+	// starts the http to https redirect listener.
+	//
+
+	if (conf.TLS.Autocert || conf.TLS.Cert != "") && conf.TLS.RedirectHTTP {
+		redirect := &http.Server{
+			Addr:    ":http",
+			Handler: http.HandlerFunc(redirectHTTPS),
+		}
+		g.Go(func() error {
+			<-ctx.Done()
+			return redirect.Shutdown(context.Background())
+		})
+		g.Go(func() error {
+			return redirect.ListenAndServe()
+		})
+	}
+
+	//
+	// starts the grpc control-plane server.
+	//
+
+	grpcServer := server.NewGRPCServer(conf, servers, provider)
+	g.Go(func() error {
+		lis, err := net.Listen("tcp", conf.GRPC.Port)
+		if err != nil {
+			return err
+		}
+		go func() {
+			<-ctx.Done()
+			grpcServer.GracefulStop()
+		}()
+		return grpcServer.Serve(lis)
+	})
+
 	//
 	// starts the auto-scaler routine.
 	//
@@ -129,6 +175,7 @@ func main() {
 			Config:   conf,
 			Servers:  servers,
 			Provider: provider,
+			Samples:  samples,
 		}, conf.Interval)
 	})
 
@@ -175,11 +222,57 @@ func setupClient(c config.Config) drone.Client {
 	return drone.NewClient(uri.String(), auther)
 }
 
+// helper function redirects http requests to their https equivalent,
+// preserving the path and query string.
+func redirectHTTPS(w http.ResponseWriter, r *http.Request) {
+	u := *r.URL
+	u.Scheme = "https"
+	u.Host = r.Host
+	http.Redirect(w, r, u.String(), http.StatusMovedPermanently)
+}
+
+// helper function configures the server store, preferring a SQL
+// backend when configured and falling back to the bolt snapshot
+// file for backwards compatibility. The queue sample store used by
+// the predictive scaler is only available against the bolt file,
+// since the SQL backends have no equivalent implementation yet.
+func setupStore(c config.Config) (store.ServerStore, store.QueueSampleStore, func() error, error) {
+	if c.Database.Driver != "" {
+		servers, err := store.Open(c.Database.Driver, c.Database.Datasource)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return servers, nil, func() error { return nil }, nil
+	}
+	db := store.Must(c.Database.Path)
+	servers := store.NewServerStore(db)
+	samples := store.NewQueueSampleStore(db)
+	return servers, samples, db.Close, nil
+}
+
 // helper function configures the hosting provider.
 func setupProvider(c config.Config) (autoscaler.Provider, error) {
+	configured := 0
+	if c.DigitalOcean.Token != "" {
+		configured++
+	}
+	if c.Amazon.AMI != "" {
+		configured++
+	}
+	if c.Hetzner.Token != "" {
+		configured++
+	}
+	if configured > 1 {
+		return nil, errors.New("multiple hosting providers configured")
+	}
+
 	switch {
 	case c.DigitalOcean.Token != "":
 		return digitalocean.FromConfig(c)
+	case c.Amazon.AMI != "":
+		return amazon.FromConfig(c)
+	case c.Hetzner.Token != "":
+		return hetzner.FromConfig(c)
 	default:
 		return nil, errors.New("missing provider configuration")
 	}
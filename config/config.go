@@ -12,7 +12,8 @@ type (
 		Interval time.Duration `default:"5m"`
 
 		Slack struct {
-			Webhook string
+			Webhook       string
+			SigningSecret string `split_words:"true"`
 		}
 
 		Logs struct {
@@ -22,9 +23,12 @@ type (
 		}
 
 		Pool struct {
-			Min    int           `default:"2"`
-			Max    int           `default:"4"`
-			MinAge time.Duration `default:"60m" split_words:"true"`
+			Min        int           `default:"2"`
+			Max        int           `default:"4"`
+			MinAge     time.Duration `default:"60m" split_words:"true"`
+			Predictive bool
+			Alpha      float64 `default:"0.5"`
+			Beta       float64 `default:"0.3"`
 		}
 
 		Server struct {
@@ -45,10 +49,15 @@ type (
 			Port string `default:":8080"`
 		}
 
+		GRPC struct {
+			Port string `default:":9090"`
+		}
+
 		TLS struct {
-			Autocert bool
-			Cert     string
-			Key      string
+			Autocert     bool
+			Cert         string
+			Key          string
+			RedirectHTTP bool `split_words:"true" default:"true"`
 		}
 
 		Prometheus struct {
@@ -56,7 +65,9 @@ type (
 		}
 
 		Database struct {
-			Path string `default:"snapshot.db"`
+			Path       string `default:"snapshot.db"`
+			Driver     string
+			Datasource string
 		}
 
 		DigitalOcean struct {
@@ -83,5 +94,22 @@ type (
 			Project      string
 			Tags         []string
 		}
+
+		Amazon struct {
+			Region        string `default:"us-east-1"`
+			AMI           string
+			InstanceType  string `split_words:"true" default:"t3.micro"`
+			Subnet        string
+			SecurityGroup string `split_words:"true"`
+			SSHKey        string `split_words:"true"`
+		}
+
+		Hetzner struct {
+			Token      string
+			Location   string `default:"nbg1"`
+			Image      string `default:"ubuntu-18.04"`
+			ServerType string `split_words:"true" default:"cx11"`
+			SSHKey     string `split_words:"true"`
+		}
 	}
 )
@@ -0,0 +1,128 @@
+// Copyright 2018 Drone.IO Inc
+// Use of this software is governed by the Business Source License
+// that can be found in the LICENSE file.
+
+// Package amazon provides a Provider implementation backed by Amazon EC2.
+package amazon
+
+import (
+	"context"
+
+	"github.com/drone/autoscaler"
+	"github.com/drone/autoscaler/config"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+type provider struct {
+	client *ec2.EC2
+
+	region        string
+	image         string
+	instanceType  string
+	subnet        string
+	securityGroup string
+	sshKey        string
+}
+
+// FromConfig returns a new EC2 Provider using the
+// settings from the configuration.
+func FromConfig(c config.Config) (autoscaler.Provider, error) {
+	sess, err := session.NewSession(&aws.Config{
+		Region: aws.String(c.Amazon.Region),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &provider{
+		client:        ec2.New(sess),
+		region:        c.Amazon.Region,
+		image:         c.Amazon.AMI,
+		instanceType:  c.Amazon.InstanceType,
+		subnet:        c.Amazon.Subnet,
+		securityGroup: c.Amazon.SecurityGroup,
+		sshKey:        c.Amazon.SSHKey,
+	}, nil
+}
+
+// Create creates an ec2 instance.
+func (p *provider) Create(ctx context.Context, opts autoscaler.Server) (autoscaler.Server, error) {
+	in := &ec2.RunInstancesInput{
+		ImageId:      aws.String(p.image),
+		InstanceType: aws.String(p.instanceType),
+		MinCount:     aws.Int64(1),
+		MaxCount:     aws.Int64(1),
+		KeyName:      aws.String(p.sshKey),
+		SubnetId:     aws.String(p.subnet),
+		SecurityGroupIds: []*string{
+			aws.String(p.securityGroup),
+		},
+		TagSpecifications: []*ec2.TagSpecification{
+			{
+				ResourceType: aws.String("instance"),
+				Tags: []*ec2.Tag{
+					{
+						Key:   aws.String("Name"),
+						Value: aws.String(opts.Name),
+					},
+				},
+			},
+		},
+	}
+
+	res, err := p.client.RunInstancesWithContext(ctx, in)
+	if err != nil {
+		return opts, err
+	}
+
+	instance := res.Instances[0]
+	opts.ID = aws.StringValue(instance.InstanceId)
+	opts.Region = p.region
+	opts.Image = p.image
+	opts.Size = p.instanceType
+	if instance.PrivateIpAddress != nil {
+		opts.Address = aws.StringValue(instance.PrivateIpAddress)
+	}
+	return opts, nil
+}
+
+// Destroy terminates the ec2 instance.
+func (p *provider) Destroy(ctx context.Context, instance autoscaler.Server) error {
+	_, err := p.client.TerminateInstancesWithContext(ctx, &ec2.TerminateInstancesInput{
+		InstanceIds: []*string{
+			aws.String(instance.ID),
+		},
+	})
+	return err
+}
+
+// List returns the list of running ec2 instances.
+func (p *provider) List(ctx context.Context) ([]autoscaler.Server, error) {
+	res, err := p.client.DescribeInstancesWithContext(ctx, &ec2.DescribeInstancesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("instance-state-name"),
+				Values: []*string{aws.String("pending"), aws.String("running")},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var servers []autoscaler.Server
+	for _, res := range res.Reservations {
+		for _, instance := range res.Instances {
+			servers = append(servers, autoscaler.Server{
+				ID:      aws.StringValue(instance.InstanceId),
+				Region:  p.region,
+				Image:   aws.StringValue(instance.ImageId),
+				Size:    aws.StringValue(instance.InstanceType),
+				Address: aws.StringValue(instance.PrivateIpAddress),
+			})
+		}
+	}
+	return servers, nil
+}
@@ -0,0 +1,51 @@
+// Copyright 2018 Drone.IO Inc
+// Use of this software is governed by the Business Source License
+// that can be found in the LICENSE file.
+
+package amazon
+
+import (
+	"testing"
+
+	"github.com/drone/autoscaler/config"
+)
+
+func TestFromConfig(t *testing.T) {
+	c := config.Config{}
+	c.Amazon.Region = "us-west-2"
+	c.Amazon.AMI = "ami-123456"
+	c.Amazon.InstanceType = "t3.medium"
+	c.Amazon.Subnet = "subnet-abc"
+	c.Amazon.SecurityGroup = "sg-abc"
+	c.Amazon.SSHKey = "my-key"
+
+	p, err := FromConfig(c)
+	if err != nil {
+		t.Fatalf("FromConfig: %v", err)
+	}
+
+	prov, ok := p.(*provider)
+	if !ok {
+		t.Fatalf("expected *provider, got %T", p)
+	}
+
+	tests := []struct {
+		field string
+		got   string
+		want  string
+	}{
+		{"region", prov.region, c.Amazon.Region},
+		{"image", prov.image, c.Amazon.AMI},
+		{"instance type", prov.instanceType, c.Amazon.InstanceType},
+		{"subnet", prov.subnet, c.Amazon.Subnet},
+		{"security group", prov.securityGroup, c.Amazon.SecurityGroup},
+		{"ssh key", prov.sshKey, c.Amazon.SSHKey},
+	}
+	for _, test := range tests {
+		t.Run(test.field, func(t *testing.T) {
+			if test.got != test.want {
+				t.Errorf("expected %s %q, got %q", test.field, test.want, test.got)
+			}
+		})
+	}
+}
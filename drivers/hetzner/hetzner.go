@@ -0,0 +1,97 @@
+// Copyright 2018 Drone.IO Inc
+// Use of this software is governed by the Business Source License
+// that can be found in the LICENSE file.
+
+// Package hetzner provides a Provider implementation backed by
+// Hetzner Cloud.
+package hetzner
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/drone/autoscaler"
+	"github.com/drone/autoscaler/config"
+
+	"github.com/hetznercloud/hcloud-go/hcloud"
+)
+
+type provider struct {
+	client *hcloud.Client
+
+	location   string
+	image      string
+	serverType string
+	sshKey     string
+}
+
+// FromConfig returns a new Hetzner Cloud Provider using the
+// settings from the configuration.
+func FromConfig(c config.Config) (autoscaler.Provider, error) {
+	client := hcloud.NewClient(
+		hcloud.WithToken(c.Hetzner.Token),
+	)
+	return &provider{
+		client:     client,
+		location:   c.Hetzner.Location,
+		image:      c.Hetzner.Image,
+		serverType: c.Hetzner.ServerType,
+		sshKey:     c.Hetzner.SSHKey,
+	}, nil
+}
+
+// Create creates a hetzner cloud server.
+func (p *provider) Create(ctx context.Context, opts autoscaler.Server) (autoscaler.Server, error) {
+	var sshKeys []*hcloud.SSHKey
+	if p.sshKey != "" {
+		sshKeys = append(sshKeys, &hcloud.SSHKey{Name: p.sshKey})
+	}
+
+	res, _, err := p.client.Server.Create(ctx, hcloud.ServerCreateOpts{
+		Name:       opts.Name,
+		ServerType: &hcloud.ServerType{Name: p.serverType},
+		Image:      &hcloud.Image{Name: p.image},
+		Location:   &hcloud.Location{Name: p.location},
+		SSHKeys:    sshKeys,
+	})
+	if err != nil {
+		return opts, err
+	}
+
+	opts.ID = strconv.FormatInt(res.Server.ID, 10)
+	opts.Region = p.location
+	opts.Image = p.image
+	opts.Size = p.serverType
+	opts.Address = res.Server.PublicNet.IPv4.IP.String()
+	return opts, nil
+}
+
+// Destroy terminates the hetzner cloud server.
+func (p *provider) Destroy(ctx context.Context, instance autoscaler.Server) error {
+	id, err := strconv.ParseInt(instance.ID, 10, 64)
+	if err != nil {
+		return err
+	}
+	_, err = p.client.Server.Delete(ctx, &hcloud.Server{ID: id})
+	return err
+}
+
+// List returns the list of hetzner cloud servers.
+func (p *provider) List(ctx context.Context) ([]autoscaler.Server, error) {
+	res, err := p.client.Server.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var servers []autoscaler.Server
+	for _, server := range res {
+		servers = append(servers, autoscaler.Server{
+			ID:      strconv.FormatInt(server.ID, 10),
+			Region:  p.location,
+			Image:   p.image,
+			Size:    p.serverType,
+			Address: server.PublicNet.IPv4.IP.String(),
+		})
+	}
+	return servers, nil
+}
@@ -0,0 +1,48 @@
+// Copyright 2018 Drone.IO Inc
+// Use of this software is governed by the Business Source License
+// that can be found in the LICENSE file.
+
+package hetzner
+
+import (
+	"testing"
+
+	"github.com/drone/autoscaler/config"
+)
+
+func TestFromConfig(t *testing.T) {
+	c := config.Config{}
+	c.Hetzner.Token = "secret-token"
+	c.Hetzner.Location = "fsn1"
+	c.Hetzner.Image = "ubuntu-20.04"
+	c.Hetzner.ServerType = "cx21"
+	c.Hetzner.SSHKey = "my-key"
+
+	p, err := FromConfig(c)
+	if err != nil {
+		t.Fatalf("FromConfig: %v", err)
+	}
+
+	prov, ok := p.(*provider)
+	if !ok {
+		t.Fatalf("expected *provider, got %T", p)
+	}
+
+	tests := []struct {
+		field string
+		got   string
+		want  string
+	}{
+		{"location", prov.location, c.Hetzner.Location},
+		{"image", prov.image, c.Hetzner.Image},
+		{"server type", prov.serverType, c.Hetzner.ServerType},
+		{"ssh key", prov.sshKey, c.Hetzner.SSHKey},
+	}
+	for _, test := range tests {
+		t.Run(test.field, func(t *testing.T) {
+			if test.got != test.want {
+				t.Errorf("expected %s %q, got %q", test.field, test.want, test.got)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright 2018 Drone.IO Inc
+// Use of this software is governed by the Business Source License
+// that can be found in the LICENSE file.
+
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var forecastQueueDepth = prometheus.NewGauge(
+	prometheus.GaugeOpts{
+		Name: "autoscaler_forecast_queue_depth",
+		Help: "Forecasted pending job count at the scaling horizon (Pool.MinAge ahead).",
+	},
+)
+
+var forecastError = prometheus.NewGauge(
+	prometheus.GaugeOpts{
+		Name: "autoscaler_forecast_error",
+		Help: "Absolute error between the forecasted and observed queue depth.",
+	},
+)
+
+func init() {
+	prometheus.MustRegister(forecastQueueDepth)
+	prometheus.MustRegister(forecastError)
+}
+
+// ForecastQueueDepth reports the predictive scaler's forecasted
+// queue depth.
+func ForecastQueueDepth(v float64) {
+	forecastQueueDepth.Set(v)
+}
+
+// ForecastError reports the absolute error between the predictive
+// scaler's forecast and the most recently observed queue depth.
+func ForecastError(v float64) {
+	forecastError.Set(v)
+}
@@ -0,0 +1,79 @@
+// Copyright 2018 Drone.IO Inc
+// Use of this software is governed by the Business Source License
+// that can be found in the LICENSE file.
+
+package scaler
+
+import (
+	"context"
+	"math"
+
+	"github.com/drone/autoscaler/config"
+	"github.com/drone/autoscaler/metrics"
+	"github.com/drone/autoscaler/store"
+)
+
+// forecaster predicts near-future queue depth from a rolling window
+// of samples using Holt-Winters double exponential smoothing, so
+// the predictive scaler can provision capacity ahead of demand
+// rather than reacting to it.
+type forecaster struct {
+	alpha float64
+	beta  float64
+
+	level float64
+	trend float64
+	init  bool
+}
+
+func newForecaster(c config.Config) *forecaster {
+	return &forecaster{
+		alpha: c.Pool.Alpha,
+		beta:  c.Pool.Beta,
+	}
+}
+
+// update feeds a new observation into the smoother, updating the
+// level and trend estimates.
+func (f *forecaster) update(y float64) {
+	if !f.init {
+		f.level = y
+		f.trend = 0
+		f.init = true
+		return
+	}
+	level := f.alpha*y + (1-f.alpha)*(f.level+f.trend)
+	trend := f.beta*(level-f.level) + (1-f.beta)*f.trend
+	f.level = level
+	f.trend = trend
+}
+
+// forecast projects the queue depth h sample-intervals into the
+// future.
+func (f *forecaster) forecast(h float64) float64 {
+	return f.level + h*f.trend
+}
+
+// predictiveTarget samples the current queue depth, feeds it into
+// the forecaster, persists it to the rolling window, and returns
+// the number of agents the pool should target so that capacity is
+// ready by the time a freshly booted server can pick up work.
+func predictiveTarget(ctx context.Context, samples store.QueueSampleStore, f *forecaster, depth int, concurrency int, horizon float64, now int64) (int, error) {
+	if err := samples.Append(ctx, store.QueueSample{Timestamp: now, Depth: depth}); err != nil {
+		return 0, err
+	}
+
+	f.update(float64(depth))
+	forecast := f.forecast(horizon)
+	if forecast < 0 {
+		forecast = 0
+	}
+
+	metrics.ForecastQueueDepth(forecast)
+	metrics.ForecastError(math.Abs(forecast - float64(depth)))
+
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	return int(math.Ceil(forecast / float64(concurrency))), nil
+}
@@ -0,0 +1,59 @@
+// Copyright 2018 Drone.IO Inc
+// Use of this software is governed by the Business Source License
+// that can be found in the LICENSE file.
+
+package scaler
+
+import (
+	"math"
+	"testing"
+
+	"github.com/drone/autoscaler/config"
+)
+
+func newTestForecaster() *forecaster {
+	c := config.Config{}
+	c.Pool.Alpha = 0.5
+	c.Pool.Beta = 0.3
+	return newForecaster(c)
+}
+
+func TestForecasterFirstSampleSeedsLevel(t *testing.T) {
+	f := newTestForecaster()
+	f.update(10)
+	if f.level != 10 {
+		t.Fatalf("expected level to seed at 10, got %v", f.level)
+	}
+	if f.trend != 0 {
+		t.Fatalf("expected trend to seed at 0, got %v", f.trend)
+	}
+}
+
+func TestForecasterTracksRisingTrend(t *testing.T) {
+	f := newTestForecaster()
+	samples := []float64{10, 20, 30, 40, 50}
+	for _, y := range samples {
+		f.update(y)
+	}
+	if f.trend <= 0 {
+		t.Fatalf("expected a positive trend for a rising series, got %v", f.trend)
+	}
+
+	forecast := f.forecast(1)
+	if forecast <= 50 {
+		t.Fatalf("expected forecast beyond the last observed sample, got %v", forecast)
+	}
+}
+
+func TestForecasterFlatSeriesConverges(t *testing.T) {
+	f := newTestForecaster()
+	for i := 0; i < 20; i++ {
+		f.update(5)
+	}
+	if math.Abs(f.level-5) > 0.01 {
+		t.Fatalf("expected level to converge to 5, got %v", f.level)
+	}
+	if math.Abs(f.trend) > 0.01 {
+		t.Fatalf("expected trend to converge to 0 for a flat series, got %v", f.trend)
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright 2018 Drone.IO Inc
+// Use of this software is governed by the Business Source License
+// that can be found in the LICENSE file.
+
+// Package scaler implements the reconcile loop that keeps the
+// server pool sized to the pending build queue.
+package scaler
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/drone/autoscaler"
+	"github.com/drone/autoscaler/config"
+	"github.com/drone/autoscaler/store"
+	"github.com/drone/drone-go/drone"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Scaler reconciles the server pool against the pending build
+// queue on a fixed interval.
+type Scaler struct {
+	Client   drone.Client
+	Config   config.Config
+	Servers  store.ServerStore
+	Provider autoscaler.Provider
+	Samples  store.QueueSampleStore
+
+	forecaster *forecaster
+}
+
+// Start runs the reconcile loop until ctx is canceled.
+func Start(ctx context.Context, s *Scaler, interval time.Duration) error {
+	s.forecaster = newForecaster(s.Config)
+	if s.Config.Pool.Predictive && s.Samples != nil {
+		if err := s.seedForecaster(ctx); err != nil {
+			return err
+		}
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if err := s.reconcile(ctx); err != nil {
+				log.Ctx(ctx).Error().Err(err).Msg("cannot reconcile server pool")
+			}
+		}
+	}
+}
+
+// reconcile samples the pending build queue, computes the target
+// pool size and creates or destroys servers to match it.
+func (s *Scaler) reconcile(ctx context.Context) error {
+	paused, err := s.Servers.Paused(ctx)
+	if err != nil {
+		return err
+	}
+	if paused {
+		return nil
+	}
+
+	stages, err := s.Client.Queue()
+	if err != nil {
+		return err
+	}
+	depth := len(stages)
+
+	target, err := s.target(ctx, depth)
+	if err != nil {
+		return err
+	}
+	if target < s.Config.Pool.Min {
+		target = s.Config.Pool.Min
+	}
+	if target > s.Config.Pool.Max {
+		target = s.Config.Pool.Max
+	}
+
+	servers, err := s.Servers.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	switch current := len(servers); {
+	case current < target:
+		return s.scaleUp(ctx, target-current)
+	case current > target:
+		return s.scaleDown(ctx, servers, current-target)
+	}
+	return nil
+}
+
+// seedForecaster replays the persisted queue-depth window into the
+// forecaster so predictions survive a process restart instead of
+// cold-starting every time the autoscaler is redeployed.
+func (s *Scaler) seedForecaster(ctx context.Context) error {
+	samples, err := s.Samples.List(ctx)
+	if err != nil {
+		return err
+	}
+	for _, sample := range samples {
+		s.forecaster.update(float64(sample.Depth))
+	}
+	return nil
+}
+
+// target returns the number of agents the pool should run given
+// the observed queue depth, using the predictive forecaster when
+// enabled and falling back to the instantaneous queue depth
+// otherwise.
+func (s *Scaler) target(ctx context.Context, depth int) (int, error) {
+	concurrency := s.Config.Agent.Concurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	if s.Config.Pool.Predictive && s.Samples != nil {
+		horizon := s.Config.Pool.MinAge.Seconds() / s.Config.Interval.Seconds()
+		return predictiveTarget(ctx, s.Samples, s.forecaster, depth, concurrency, horizon, time.Now().Unix())
+	}
+
+	return int(math.Ceil(float64(depth) / float64(concurrency))), nil
+}
+
+// scaleUp provisions n new servers and adds them to the store.
+func (s *Scaler) scaleUp(ctx context.Context, n int) error {
+	for i := 0; i < n; i++ {
+		server, err := s.Provider.Create(ctx, autoscaler.Server{
+			Name:    newServerName(),
+			Created: time.Now().Unix(),
+		})
+		if err != nil {
+			return err
+		}
+		if server.Created == 0 {
+			server.Created = time.Now().Unix()
+		}
+		if err := s.Servers.Create(ctx, &server); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scaleDown destroys up to n servers that are older than
+// Pool.MinAge, oldest first, so freshly booted agents are not
+// reclaimed before they can pick up work.
+func (s *Scaler) scaleDown(ctx context.Context, servers []*autoscaler.Server, n int) error {
+	now := time.Now().Unix()
+	minAge := int64(s.Config.Pool.MinAge.Seconds())
+
+	sort.Slice(servers, func(i, j int) bool {
+		return servers[i].Created < servers[j].Created
+	})
+
+	destroyed := 0
+	for _, server := range servers {
+		if destroyed >= n {
+			break
+		}
+		if now-server.Created < minAge {
+			continue
+		}
+		if err := s.Provider.Destroy(ctx, *server); err != nil {
+			return err
+		}
+		if err := s.Servers.Delete(ctx, server); err != nil {
+			return err
+		}
+		destroyed++
+	}
+	return nil
+}
+
+// newServerName returns a unique name for an automatically
+// provisioned server, since both store backends key servers by
+// name.
+func newServerName() string {
+	suffix := make([]byte, 4)
+	rand.Read(suffix)
+	return fmt.Sprintf("agent-%s", hex.EncodeToString(suffix))
+}
@@ -0,0 +1,54 @@
+// Copyright 2018 Drone.IO Inc
+// Use of this software is governed by the Business Source License
+// that can be found in the LICENSE file.
+
+package scaler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/drone/autoscaler/config"
+	"github.com/drone/autoscaler/store"
+)
+
+type fakeQueueSampleStore struct {
+	samples []store.QueueSample
+}
+
+func (f *fakeQueueSampleStore) Append(ctx context.Context, sample store.QueueSample) error {
+	f.samples = append(f.samples, sample)
+	return nil
+}
+
+func (f *fakeQueueSampleStore) List(ctx context.Context) ([]store.QueueSample, error) {
+	return f.samples, nil
+}
+
+func TestSeedForecasterReplaysPersistedWindow(t *testing.T) {
+	c := config.Config{}
+	c.Pool.Alpha = 0.5
+	c.Pool.Beta = 0.3
+
+	s := &Scaler{
+		Config: c,
+		Samples: &fakeQueueSampleStore{
+			samples: []store.QueueSample{
+				{Timestamp: 1, Depth: 10},
+				{Timestamp: 2, Depth: 20},
+				{Timestamp: 3, Depth: 30},
+			},
+		},
+		forecaster: newForecaster(c),
+	}
+
+	if err := s.seedForecaster(context.Background()); err != nil {
+		t.Fatalf("seedForecaster: %v", err)
+	}
+	if s.forecaster.level == 0 {
+		t.Fatalf("expected forecaster level to be seeded from persisted samples, got %v", s.forecaster.level)
+	}
+	if s.forecaster.trend <= 0 {
+		t.Fatalf("expected forecaster to pick up the rising trend from persisted samples, got %v", s.forecaster.trend)
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright 2018 Drone.IO Inc
+// Use of this software is governed by the Business Source License
+// that can be found in the LICENSE file.
+
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/drone/autoscaler"
+	"github.com/drone/autoscaler/config"
+	"github.com/drone/autoscaler/proto"
+	"github.com/drone/autoscaler/store"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// NewGRPCServer returns a new gRPC server exposing the autoscaler
+// control plane, backed by the same server store and provider used
+// by the REST handlers.
+func NewGRPCServer(c config.Config, servers store.ServerStore, provider autoscaler.Provider) *grpc.Server {
+	srv := grpc.NewServer(
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			Time:    60 * time.Second,
+			Timeout: 20 * time.Second,
+		}),
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             30 * time.Second,
+			PermitWithoutStream: true,
+		}),
+		grpc.UnaryInterceptor(tokenInterceptor(c.Server.Token)),
+	)
+	proto.RegisterAutoscalerServer(srv, &grpcHandler{
+		servers:  servers,
+		provider: provider,
+	})
+	return srv
+}
+
+// tokenInterceptor authenticates unary gRPC calls using a bearer
+// token passed in call metadata, mirroring CheckDrone for the REST
+// handlers.
+func tokenInterceptor(token string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, "missing metadata")
+		}
+		values := md.Get("authorization")
+		if len(values) == 0 || values[0] != "Bearer "+token {
+			return nil, status.Error(codes.Unauthenticated, "invalid or missing token")
+		}
+		return handler(ctx, req)
+	}
+}
+
+type grpcHandler struct {
+	servers  store.ServerStore
+	provider autoscaler.Provider
+}
+
+func (h *grpcHandler) ListServers(ctx context.Context, req *proto.ListServersRequest) (*proto.ListServersResponse, error) {
+	list, err := h.servers.List(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	res := new(proto.ListServersResponse)
+	for _, server := range list {
+		res.Servers = append(res.Servers, convertServer(*server))
+	}
+	return res, nil
+}
+
+func (h *grpcHandler) CreateServer(ctx context.Context, req *proto.CreateServerRequest) (*proto.Server, error) {
+	server := &autoscaler.Server{Name: req.Name}
+	created, err := h.provider.Create(ctx, *server)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if err := h.servers.Create(ctx, &created); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return convertServer(created), nil
+}
+
+func (h *grpcHandler) DestroyServer(ctx context.Context, req *proto.DestroyServerRequest) (*proto.DestroyServerResponse, error) {
+	server, err := h.servers.Find(ctx, req.Name)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err := h.provider.Destroy(ctx, *server); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if err := h.servers.Delete(ctx, server); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return new(proto.DestroyServerResponse), nil
+}
+
+func (h *grpcHandler) PauseScaling(ctx context.Context, req *proto.PauseScalingRequest) (*proto.PauseScalingResponse, error) {
+	return new(proto.PauseScalingResponse), h.servers.Pause(ctx)
+}
+
+func (h *grpcHandler) ResumeScaling(ctx context.Context, req *proto.ResumeScalingRequest) (*proto.ResumeScalingResponse, error) {
+	return new(proto.ResumeScalingResponse), h.servers.Resume(ctx)
+}
+
+func (h *grpcHandler) StreamEvents(req *proto.StreamEventsRequest, stream proto.Autoscaler_StreamEventsServer) error {
+	events := h.servers.Subscribe(stream.Context())
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case event, ok := <-events:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(convertEvent(event)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func convertServer(server autoscaler.Server) *proto.Server {
+	return &proto.Server{
+		Name:    server.Name,
+		Address: server.Address,
+		Region:  server.Region,
+		Image:   server.Image,
+		Size:    server.Size,
+		Created: server.Created,
+		Paused:  server.Paused,
+	}
+}
+
+func convertEvent(event store.Event) *proto.Event {
+	return &proto.Event{
+		Kind:    convertEventKind(event.Kind),
+		Server:  convertServer(event.Server),
+		Created: event.Created,
+	}
+}
+
+// convertEventKind maps a store.EventKind onto its proto.Event_Kind
+// equivalent. The two enums are not numerically aligned: proto
+// reserves 0 for UNKNOWN, so this cannot be a raw numeric cast.
+func convertEventKind(kind store.EventKind) proto.Event_Kind {
+	switch kind {
+	case store.EventServerCreated:
+		return proto.Event_SERVER_CREATED
+	case store.EventServerDestroyed:
+		return proto.Event_SERVER_DESTROYED
+	case store.EventScalingPaused:
+		return proto.Event_SCALING_PAUSED
+	case store.EventScalingResumed:
+		return proto.Event_SCALING_RESUMED
+	default:
+		return proto.Event_UNKNOWN
+	}
+}
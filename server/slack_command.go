@@ -0,0 +1,232 @@
+// Copyright 2018 Drone.IO Inc
+// Use of this software is governed by the Business Source License
+// that can be found in the LICENSE file.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/drone/autoscaler"
+	"github.com/drone/autoscaler/config"
+	"github.com/drone/autoscaler/store"
+)
+
+var errSlackSignature = errors.New("server: invalid slack signature")
+
+// HandleSlackCommand returns an http.HandlerFunc that accepts
+// Slack's slash-command callback, verifies the request signature
+// and dispatches the command text to the server pool.
+func HandleSlackCommand(servers store.ServerStore, provider autoscaler.Provider, c config.Config) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		if err := verifySlackSignature(r, body, c.Slack.SigningSecret); err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		r.ParseForm()
+		text := strings.TrimSpace(r.Form.Get("text"))
+		fields := strings.Fields(text)
+
+		msg, inChannel, err := dispatchSlackCommand(r.Context(), servers, provider, c, fields)
+		if err != nil {
+			msg = fmt.Sprintf("error: %s", err)
+			inChannel = false
+		}
+
+		responseType := "ephemeral"
+		if inChannel {
+			responseType = "in_channel"
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]string{
+			"response_type": responseType,
+			"text":          msg,
+		})
+	}
+}
+
+// dispatchSlackCommand parses the slash-command text and invokes
+// the matching operation against the server store and provider.
+func dispatchSlackCommand(ctx context.Context, servers store.ServerStore, provider autoscaler.Provider, c config.Config, fields []string) (string, bool, error) {
+	if len(fields) == 0 {
+		return "usage: status | list | scale up N | scale down N | pause | resume | destroy <name>", false, nil
+	}
+
+	switch fields[0] {
+	case "status":
+		list, err := servers.List(ctx)
+		if err != nil {
+			return "", false, err
+		}
+		return fmt.Sprintf("%d servers running", len(list)), true, nil
+
+	case "list":
+		list, err := servers.List(ctx)
+		if err != nil {
+			return "", false, err
+		}
+		var names []string
+		for _, server := range list {
+			names = append(names, server.Name)
+		}
+		return strings.Join(names, ", "), false, nil
+
+	case "scale":
+		if len(fields) != 3 || (fields[1] != "up" && fields[1] != "down") {
+			return "usage: scale up|down N", false, nil
+		}
+		n, err := strconv.Atoi(fields[2])
+		if err != nil {
+			return "", false, err
+		}
+		if fields[1] == "down" {
+			return scaleDown(ctx, servers, provider, c, n)
+		}
+		return scaleUp(ctx, servers, provider, n)
+
+	case "pause":
+		if err := servers.Pause(ctx); err != nil {
+			return "", false, err
+		}
+		return "scaling paused", true, nil
+
+	case "resume":
+		if err := servers.Resume(ctx); err != nil {
+			return "", false, err
+		}
+		return "scaling resumed", true, nil
+
+	case "destroy":
+		if len(fields) != 2 {
+			return "usage: destroy <name>", false, nil
+		}
+		server, err := servers.Find(ctx, fields[1])
+		if err != nil {
+			return "", false, err
+		}
+		if err := provider.Destroy(ctx, *server); err != nil {
+			return "", false, err
+		}
+		if err := servers.Delete(ctx, server); err != nil {
+			return "", false, err
+		}
+		return fmt.Sprintf("destroyed %s", fields[1]), true, nil
+
+	default:
+		return "unrecognized command", false, nil
+	}
+}
+
+// scaleUp provisions n new servers and persists each to the store,
+// mirroring the gRPC CreateServer handler.
+func scaleUp(ctx context.Context, servers store.ServerStore, provider autoscaler.Provider, n int) (string, bool, error) {
+	for i := 0; i < n; i++ {
+		created, err := provider.Create(ctx, autoscaler.Server{
+			Name:    newServerName(),
+			Created: time.Now().Unix(),
+		})
+		if err != nil {
+			return "", false, err
+		}
+		if created.Created == 0 {
+			created.Created = time.Now().Unix()
+		}
+		if err := servers.Create(ctx, &created); err != nil {
+			return "", false, err
+		}
+	}
+	return fmt.Sprintf("scaling up by %d", n), true, nil
+}
+
+// scaleDown destroys up to n servers that are older than
+// Pool.MinAge, the same eligibility window the reconcile loop
+// uses, and removes them from the store.
+func scaleDown(ctx context.Context, servers store.ServerStore, provider autoscaler.Provider, c config.Config, n int) (string, bool, error) {
+	list, err := servers.List(ctx)
+	if err != nil {
+		return "", false, err
+	}
+
+	now := time.Now().Unix()
+	minAge := int64(c.Pool.MinAge.Seconds())
+
+	destroyed := 0
+	for _, server := range list {
+		if destroyed >= n {
+			break
+		}
+		if now-server.Created < minAge {
+			continue
+		}
+		if err := provider.Destroy(ctx, *server); err != nil {
+			return "", false, err
+		}
+		if err := servers.Delete(ctx, server); err != nil {
+			return "", false, err
+		}
+		destroyed++
+	}
+	return fmt.Sprintf("destroyed %d of %d requested servers", destroyed, n), true, nil
+}
+
+// verifySlackSignature validates the X-Slack-Signature header
+// against the shared signing secret, computed over the raw request
+// body, as documented at
+// https://api.slack.com/authentication/verifying-requests-from-slack.
+func verifySlackSignature(r *http.Request, body []byte, secret string) error {
+	ts := r.Header.Get("X-Slack-Request-Timestamp")
+	sig := r.Header.Get("X-Slack-Signature")
+	if ts == "" || sig == "" {
+		return errSlackSignature
+	}
+
+	seconds, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return errSlackSignature
+	}
+	if time.Since(time.Unix(seconds, 0)) > 5*time.Minute {
+		return errSlackSignature
+	}
+
+	base := "v0:" + ts + ":" + string(body)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(base))
+	expected := "v0=" + hex.EncodeToString(mac.Sum(nil))
+
+	if !hmac.Equal([]byte(expected), []byte(sig)) {
+		return errSlackSignature
+	}
+	return nil
+}
+
+// newServerName returns a unique name for an automatically
+// provisioned server, since both store backends key servers by
+// name.
+func newServerName() string {
+	suffix := make([]byte, 4)
+	rand.Read(suffix)
+	return fmt.Sprintf("agent-%s", hex.EncodeToString(suffix))
+}
@@ -0,0 +1,179 @@
+// Copyright 2018 Drone.IO Inc
+// Use of this software is governed by the Business Source License
+// that can be found in the LICENSE file.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/drone/autoscaler"
+	"github.com/drone/autoscaler/config"
+	"github.com/drone/autoscaler/store"
+)
+
+func signRequest(t *testing.T, secret, body string, ts int64) string {
+	t.Helper()
+	base := "v0:" + strconv.FormatInt(ts, 10) + ":" + body
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(base))
+	return "v0=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestVerifySlackSignature(t *testing.T) {
+	secret := "shhh"
+	body := "text=status&token=xyz"
+	ts := time.Now().Unix()
+	sig := signRequest(t, secret, body, ts)
+
+	r := httptest.NewRequest(http.MethodPost, "/slack/command", bytes.NewBufferString(body))
+	r.Header.Set("X-Slack-Request-Timestamp", strconv.FormatInt(ts, 10))
+	r.Header.Set("X-Slack-Signature", sig)
+
+	if err := verifySlackSignature(r, []byte(body), secret); err != nil {
+		t.Fatalf("expected valid signature, got %v", err)
+	}
+}
+
+func TestVerifySlackSignatureRejectsTamperedBody(t *testing.T) {
+	secret := "shhh"
+	ts := time.Now().Unix()
+	sig := signRequest(t, secret, "text=status", ts)
+
+	r := httptest.NewRequest(http.MethodPost, "/slack/command", bytes.NewBufferString("text=destroy+everything"))
+	r.Header.Set("X-Slack-Request-Timestamp", strconv.FormatInt(ts, 10))
+	r.Header.Set("X-Slack-Signature", sig)
+
+	if err := verifySlackSignature(r, []byte("text=destroy+everything"), secret); err == nil {
+		t.Fatal("expected signature mismatch for tampered body")
+	}
+}
+
+func TestVerifySlackSignatureRejectsStaleTimestamp(t *testing.T) {
+	secret := "shhh"
+	body := "text=status"
+	ts := time.Now().Add(-10 * time.Minute).Unix()
+	sig := signRequest(t, secret, body, ts)
+
+	r := httptest.NewRequest(http.MethodPost, "/slack/command", bytes.NewBufferString(body))
+	r.Header.Set("X-Slack-Request-Timestamp", strconv.FormatInt(ts, 10))
+	r.Header.Set("X-Slack-Signature", sig)
+
+	if err := verifySlackSignature(r, []byte(body), secret); err == nil {
+		t.Fatal("expected stale timestamp to be rejected")
+	}
+}
+
+// fakeServerStore is a minimal in-memory store.ServerStore used to
+// exercise dispatchSlackCommand without a real database.
+type fakeServerStore struct {
+	servers []*autoscaler.Server
+	paused  bool
+}
+
+func (f *fakeServerStore) List(ctx context.Context) ([]*autoscaler.Server, error) {
+	return f.servers, nil
+}
+
+func (f *fakeServerStore) Find(ctx context.Context, name string) (*autoscaler.Server, error) {
+	for _, s := range f.servers {
+		if s.Name == name {
+			return s, nil
+		}
+	}
+	return nil, errSlackSignature
+}
+
+func (f *fakeServerStore) Create(ctx context.Context, server *autoscaler.Server) error {
+	f.servers = append(f.servers, server)
+	return nil
+}
+
+func (f *fakeServerStore) Update(ctx context.Context, server *autoscaler.Server) error {
+	return nil
+}
+
+func (f *fakeServerStore) Delete(ctx context.Context, server *autoscaler.Server) error {
+	for i, s := range f.servers {
+		if s.Name == server.Name {
+			f.servers = append(f.servers[:i], f.servers[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+func (f *fakeServerStore) Pause(ctx context.Context) error  { f.paused = true; return nil }
+func (f *fakeServerStore) Resume(ctx context.Context) error { f.paused = false; return nil }
+func (f *fakeServerStore) Paused(ctx context.Context) (bool, error) {
+	return f.paused, nil
+}
+func (f *fakeServerStore) Subscribe(ctx context.Context) <-chan store.Event {
+	return make(chan store.Event)
+}
+
+type fakeProvider struct {
+	destroyed []string
+}
+
+func (p *fakeProvider) Create(ctx context.Context, server autoscaler.Server) (autoscaler.Server, error) {
+	return server, nil
+}
+
+func (p *fakeProvider) Destroy(ctx context.Context, server autoscaler.Server) error {
+	p.destroyed = append(p.destroyed, server.Name)
+	return nil
+}
+
+func (p *fakeProvider) List(ctx context.Context) ([]autoscaler.Server, error) {
+	return nil, nil
+}
+
+func TestScaleDownRespectsMinAge(t *testing.T) {
+	now := time.Now().Unix()
+	servers := &fakeServerStore{
+		servers: []*autoscaler.Server{
+			{Name: "old", Created: now - 3600},
+			{Name: "new", Created: now},
+		},
+	}
+	provider := &fakeProvider{}
+
+	c := config.Config{}
+	c.Pool.MinAge = 30 * time.Minute
+
+	msg, inChannel, err := scaleDown(context.Background(), servers, provider, c, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !inChannel {
+		t.Fatal("expected in-channel response")
+	}
+	if len(provider.destroyed) != 1 || provider.destroyed[0] != "old" {
+		t.Fatalf("expected only the eligible server to be destroyed, got %v", provider.destroyed)
+	}
+	if msg == "" {
+		t.Fatal("expected a non-empty response message")
+	}
+}
+
+func TestScaleUpPersistsCreatedServers(t *testing.T) {
+	servers := &fakeServerStore{}
+	provider := &fakeProvider{}
+
+	if _, _, err := scaleUp(context.Background(), servers, provider, 3); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(servers.servers) != 3 {
+		t.Fatalf("expected 3 servers to be tracked in the store, got %d", len(servers.servers))
+	}
+}
@@ -0,0 +1,190 @@
+// Copyright 2018 Drone.IO Inc
+// Use of this software is governed by the Business Source License
+// that can be found in the LICENSE file.
+
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/drone/autoscaler"
+
+	bolt "github.com/boltdb/bolt"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	serverBucket = []byte("servers")
+	stateBucket  = []byte("state")
+	pausedKey    = []byte("paused")
+)
+
+// Must opens the bolt database at path, creating it and its
+// buckets if necessary, and exits the process on failure. This
+// preserves the single-file deployment model for operators not
+// using a shared SQL backend.
+func Must(path string) *bolt.DB {
+	db, err := bolt.Open(path, 0600, nil)
+	if err != nil {
+		log.Fatal().Err(err).Msg("cannot open database")
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		if _, err := tx.CreateBucketIfNotExists(serverBucket); err != nil {
+			return err
+		}
+		_, err := tx.CreateBucketIfNotExists(stateBucket)
+		return err
+	})
+	if err != nil {
+		log.Fatal().Err(err).Msg("cannot initialize database")
+	}
+	return db
+}
+
+// NewServerStore returns a bolt-backed ServerStore.
+func NewServerStore(db *bolt.DB) ServerStore {
+	return &boltServerStore{db: db}
+}
+
+type boltServerStore struct {
+	db   *bolt.DB
+	mu   sync.Mutex
+	subs []chan Event
+}
+
+func (s *boltServerStore) List(ctx context.Context) ([]*autoscaler.Server, error) {
+	var servers []*autoscaler.Server
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(serverBucket).ForEach(func(k, v []byte) error {
+			server := new(autoscaler.Server)
+			if err := json.Unmarshal(v, server); err != nil {
+				return err
+			}
+			servers = append(servers, server)
+			return nil
+		})
+	})
+	return servers, err
+}
+
+func (s *boltServerStore) Find(ctx context.Context, name string) (*autoscaler.Server, error) {
+	server := new(autoscaler.Server)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(serverBucket).Get([]byte(name))
+		if data == nil {
+			return errServerNotFound
+		}
+		return json.Unmarshal(data, server)
+	})
+	return server, err
+}
+
+func (s *boltServerStore) Create(ctx context.Context, server *autoscaler.Server) error {
+	if err := s.put(server); err != nil {
+		return err
+	}
+	s.publish(Event{Kind: EventServerCreated, Server: *server})
+	return nil
+}
+
+func (s *boltServerStore) Update(ctx context.Context, server *autoscaler.Server) error {
+	return s.put(server)
+}
+
+func (s *boltServerStore) Delete(ctx context.Context, server *autoscaler.Server) error {
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(serverBucket).Delete([]byte(server.Name))
+	})
+	if err != nil {
+		return err
+	}
+	s.publish(Event{Kind: EventServerDestroyed, Server: *server})
+	return nil
+}
+
+func (s *boltServerStore) put(server *autoscaler.Server) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		data, err := json.Marshal(server)
+		if err != nil {
+			return err
+		}
+		return tx.Bucket(serverBucket).Put([]byte(server.Name), data)
+	})
+}
+
+func (s *boltServerStore) Pause(ctx context.Context) error {
+	if err := s.setPaused(true); err != nil {
+		return err
+	}
+	s.publish(Event{Kind: EventScalingPaused})
+	return nil
+}
+
+func (s *boltServerStore) Resume(ctx context.Context) error {
+	if err := s.setPaused(false); err != nil {
+		return err
+	}
+	s.publish(Event{Kind: EventScalingResumed})
+	return nil
+}
+
+func (s *boltServerStore) Paused(ctx context.Context) (bool, error) {
+	var paused bool
+	err := s.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(stateBucket).Get(pausedKey)
+		paused = len(data) == 1 && data[0] == 1
+		return nil
+	})
+	return paused, err
+}
+
+func (s *boltServerStore) setPaused(paused bool) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		value := byte(0)
+		if paused {
+			value = 1
+		}
+		return tx.Bucket(stateBucket).Put(pausedKey, []byte{value})
+	})
+}
+
+// Subscribe returns a channel of lifecycle events. The channel is
+// closed when ctx is canceled.
+func (s *boltServerStore) Subscribe(ctx context.Context) <-chan Event {
+	ch := make(chan Event, 16)
+
+	s.mu.Lock()
+	s.subs = append(s.subs, ch)
+	s.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		for i, sub := range s.subs {
+			if sub == ch {
+				s.subs = append(s.subs[:i], s.subs[i+1:]...)
+				break
+			}
+		}
+		close(ch)
+	}()
+
+	return ch
+}
+
+func (s *boltServerStore) publish(event Event) {
+	event.Created = time.Now().Unix()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, sub := range s.subs {
+		select {
+		case sub <- event:
+		default:
+		}
+	}
+}
@@ -0,0 +1,12 @@
+// Copyright 2018 Drone.IO Inc
+// Use of this software is governed by the Business Source License
+// that can be found in the LICENSE file.
+
+package store
+
+import "errors"
+
+// errServerNotFound is returned by the bolt store's Find when no
+// server with the requested name is present. The SQL store's Find
+// returns the database driver's error instead.
+var errServerNotFound = errors.New("store: server not found")
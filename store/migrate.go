@@ -0,0 +1,73 @@
+// Copyright 2018 Drone.IO Inc
+// Use of this software is governed by the Business Source License
+// that can be found in the LICENSE file.
+
+package store
+
+import "database/sql"
+
+// schema creates the servers and scaler_state tables for each
+// supported driver. Kept as a small set of idempotent statements
+// per driver since the autoscaler schema is small and stable; a
+// migration framework is overkill. Statements are run individually
+// rather than joined by ";" since mysql only executes multiple
+// statements per query when the driver is configured with
+// multiStatements=true.
+var schema = map[string][]string{
+	"sqlite3": {
+		`CREATE TABLE IF NOT EXISTS servers (
+ server_name    TEXT PRIMARY KEY
+,server_address TEXT
+,server_region  TEXT
+,server_image   TEXT
+,server_size    TEXT
+,server_created INTEGER
+)`,
+		`CREATE TABLE IF NOT EXISTS scaler_state (
+ state_id     INTEGER PRIMARY KEY
+,state_paused INTEGER NOT NULL DEFAULT 0
+)`,
+		`INSERT OR IGNORE INTO scaler_state (state_id, state_paused) VALUES (1, 0)`,
+	},
+	"postgres": {
+		`CREATE TABLE IF NOT EXISTS servers (
+ server_name    TEXT PRIMARY KEY
+,server_address TEXT
+,server_region  TEXT
+,server_image   TEXT
+,server_size    TEXT
+,server_created BIGINT
+)`,
+		`CREATE TABLE IF NOT EXISTS scaler_state (
+ state_id     INTEGER PRIMARY KEY
+,state_paused BOOLEAN NOT NULL DEFAULT FALSE
+)`,
+		`INSERT INTO scaler_state (state_id, state_paused) VALUES (1, FALSE) ON CONFLICT (state_id) DO NOTHING`,
+	},
+	"mysql": {
+		`CREATE TABLE IF NOT EXISTS servers (
+ server_name    VARCHAR(255) PRIMARY KEY
+,server_address VARCHAR(255)
+,server_region  VARCHAR(255)
+,server_image   VARCHAR(255)
+,server_size    VARCHAR(255)
+,server_created BIGINT
+)`,
+		`CREATE TABLE IF NOT EXISTS scaler_state (
+ state_id     INTEGER PRIMARY KEY
+,state_paused BOOLEAN NOT NULL DEFAULT FALSE
+)`,
+		`INSERT IGNORE INTO scaler_state (state_id, state_paused) VALUES (1, FALSE)`,
+	},
+}
+
+// migrate creates the schema for the given driver if it does not
+// already exist.
+func migrate(driver string, db *sql.DB) error {
+	for _, stmt := range schema[driver] {
+		if _, err := db.Exec(stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+// Copyright 2018 Drone.IO Inc
+// Use of this software is governed by the Business Source License
+// that can be found in the LICENSE file.
+
+package store
+
+import "context"
+
+// queueWindow bounds the number of queue-depth samples retained for
+// forecasting, roughly 24h of history at a 5m sample interval.
+const queueWindow = 288
+
+// QueueSample records the pending job count observed at a point in
+// time, used as input to the predictive scaler's forecast.
+type QueueSample struct {
+	Timestamp int64 `json:"timestamp"`
+	Depth     int   `json:"depth"`
+}
+
+// QueueSampleStore persists the rolling window of queue-depth
+// samples used by the predictive scaler.
+type QueueSampleStore interface {
+	// Append records a new sample, trimming the oldest entry once
+	// the window exceeds its maximum size.
+	Append(ctx context.Context, sample QueueSample) error
+
+	// List returns the samples in the current window, oldest first.
+	List(ctx context.Context) ([]QueueSample, error)
+}
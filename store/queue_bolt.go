@@ -0,0 +1,87 @@
+// Copyright 2018 Drone.IO Inc
+// Use of this software is governed by the Business Source License
+// that can be found in the LICENSE file.
+
+package store
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+
+	bolt "github.com/boltdb/bolt"
+)
+
+var queueBucket = []byte("queue_samples")
+
+type queueSampleStore struct {
+	db *bolt.DB
+}
+
+// NewQueueSampleStore returns a QueueSampleStore backed by the same
+// bolt database handle used by NewServerStore.
+func NewQueueSampleStore(db *bolt.DB) QueueSampleStore {
+	db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(queueBucket)
+		return err
+	})
+	return &queueSampleStore{db: db}
+}
+
+func (s *queueSampleStore) Append(ctx context.Context, sample QueueSample) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(queueBucket)
+
+		data, err := json.Marshal(sample)
+		if err != nil {
+			return err
+		}
+
+		key := make([]byte, 8)
+		binary.BigEndian.PutUint64(key, uint64(sample.Timestamp))
+		if err := bucket.Put(key, data); err != nil {
+			return err
+		}
+
+		return trimQueueWindow(bucket)
+	})
+}
+
+func (s *queueSampleStore) List(ctx context.Context) ([]QueueSample, error) {
+	var samples []QueueSample
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(queueBucket)
+		return bucket.ForEach(func(k, v []byte) error {
+			sample := QueueSample{}
+			if err := json.Unmarshal(v, &sample); err != nil {
+				return err
+			}
+			samples = append(samples, sample)
+			return nil
+		})
+	})
+	return samples, err
+}
+
+// trimQueueWindow removes the oldest samples once the bucket grows
+// beyond queueWindow entries. Bolt keys are sorted, so the oldest
+// samples are always the first encountered in bucket order.
+func trimQueueWindow(bucket *bolt.Bucket) error {
+	c := bucket.Cursor()
+	count := 0
+	for k, _ := c.First(); k != nil; k, _ = c.Next() {
+		count++
+	}
+	excess := count - queueWindow
+	if excess <= 0 {
+		return nil
+	}
+
+	for k, _ := c.First(); k != nil && excess > 0; k, _ = c.Next() {
+		if err := bucket.Delete(k); err != nil {
+			return err
+		}
+		excess--
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+// Copyright 2018 Drone.IO Inc
+// Use of this software is governed by the Business Source License
+// that can be found in the LICENSE file.
+
+package store
+
+import (
+	"context"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	bolt "github.com/boltdb/bolt"
+)
+
+func openTestQueueStore(t *testing.T) QueueSampleStore {
+	t.Helper()
+	db, err := bolt.Open(filepath.Join(t.TempDir(), "queue.db"), 0600, nil)
+	if err != nil {
+		t.Fatalf("bolt.Open: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return NewQueueSampleStore(db)
+}
+
+func TestQueueSampleStoreAppendAndList(t *testing.T) {
+	samples := openTestQueueStore(t)
+	ctx := context.Background()
+
+	for i := int64(0); i < 3; i++ {
+		if err := samples.Append(ctx, QueueSample{Timestamp: i, Depth: int(i) * 10}); err != nil {
+			t.Fatalf("Append: %v", err)
+		}
+	}
+
+	list, err := samples.List(ctx)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(list) != 3 {
+		t.Fatalf("expected 3 samples, got %d", len(list))
+	}
+
+	sort.Slice(list, func(i, j int) bool { return list[i].Timestamp < list[j].Timestamp })
+	for i, sample := range list {
+		if sample.Timestamp != int64(i) || sample.Depth != i*10 {
+			t.Fatalf("unexpected sample at %d: %+v", i, sample)
+		}
+	}
+}
+
+func TestQueueSampleStoreTrimsWindow(t *testing.T) {
+	samples := openTestQueueStore(t)
+	ctx := context.Background()
+
+	for i := int64(0); i < queueWindow+10; i++ {
+		if err := samples.Append(ctx, QueueSample{Timestamp: i, Depth: 1}); err != nil {
+			t.Fatalf("Append: %v", err)
+		}
+	}
+
+	list, err := samples.List(ctx)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(list) != queueWindow {
+		t.Fatalf("expected window trimmed to %d samples, got %d", queueWindow, len(list))
+	}
+
+	for _, sample := range list {
+		if sample.Timestamp < 10 {
+			t.Fatalf("expected oldest samples to be trimmed first, found timestamp %d", sample.Timestamp)
+		}
+	}
+}
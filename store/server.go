@@ -0,0 +1,63 @@
+// Copyright 2018 Drone.IO Inc
+// Use of this software is governed by the Business Source License
+// that can be found in the LICENSE file.
+
+package store
+
+import (
+	"context"
+
+	"github.com/drone/autoscaler"
+)
+
+// EventKind identifies the type of lifecycle event emitted by a
+// ServerStore subscription.
+type EventKind int
+
+// Event kinds emitted on the ServerStore subscription channel.
+const (
+	EventServerCreated EventKind = iota
+	EventServerDestroyed
+	EventScalingPaused
+	EventScalingResumed
+)
+
+// Event describes a server pool lifecycle event, streamed to gRPC
+// clients via Autoscaler_StreamEvents.
+type Event struct {
+	Kind    EventKind
+	Server  autoscaler.Server
+	Created int64
+}
+
+// ServerStore persists the set of managed servers and the paused
+// state of the scaling loop.
+type ServerStore interface {
+	// List returns the list of managed servers.
+	List(ctx context.Context) ([]*autoscaler.Server, error)
+
+	// Find returns a managed server by name.
+	Find(ctx context.Context, name string) (*autoscaler.Server, error)
+
+	// Create persists a newly provisioned server.
+	Create(ctx context.Context, server *autoscaler.Server) error
+
+	// Update persists changes to a managed server.
+	Update(ctx context.Context, server *autoscaler.Server) error
+
+	// Delete removes a managed server.
+	Delete(ctx context.Context, server *autoscaler.Server) error
+
+	// Pause suspends the scaling loop.
+	Pause(ctx context.Context) error
+
+	// Resume resumes the scaling loop.
+	Resume(ctx context.Context) error
+
+	// Paused reports whether the scaling loop is currently paused.
+	Paused(ctx context.Context) (bool, error)
+
+	// Subscribe streams server pool lifecycle events until the
+	// context is canceled.
+	Subscribe(ctx context.Context) <-chan Event
+}
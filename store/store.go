@@ -0,0 +1,165 @@
+// Copyright 2018 Drone.IO Inc
+// Use of this software is governed by the Business Source License
+// that can be found in the LICENSE file.
+
+package store
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/drone/autoscaler"
+
+	"github.com/jmoiron/sqlx"
+
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// Open opens a SQL-backed ServerStore for the given driver and
+// datasource. Supported drivers are sqlite3, postgres and mysql.
+// This allows the autoscaler to run against a shared database when
+// deployed behind a load balancer, instead of the single-file bolt
+// snapshot used by Must.
+func Open(driver, datasource string) (ServerStore, error) {
+	switch driver {
+	case "sqlite3", "postgres", "mysql":
+		db, err := sqlx.Open(driver, datasource)
+		if err != nil {
+			return nil, err
+		}
+		if err := db.Ping(); err != nil {
+			return nil, err
+		}
+		if err := migrate(driver, db.DB); err != nil {
+			return nil, fmt.Errorf("store: migration failed: %v", err)
+		}
+		return &sqlStore{db: db, driver: driver}, nil
+	default:
+		return nil, fmt.Errorf("store: unsupported database driver %q", driver)
+	}
+}
+
+// sqlStore implements ServerStore on top of database/sql, used by
+// the postgres, mysql and sqlite3 drivers. Lifecycle events are
+// broadcast to in-process subscribers only; there is no durable
+// event log.
+type sqlStore struct {
+	db     *sqlx.DB
+	driver string
+
+	mu   sync.Mutex
+	subs []chan Event
+}
+
+func (s *sqlStore) List(ctx context.Context) ([]*autoscaler.Server, error) {
+	var servers []*autoscaler.Server
+	err := s.db.SelectContext(ctx, &servers, "SELECT * FROM servers")
+	return servers, err
+}
+
+func (s *sqlStore) Find(ctx context.Context, name string) (*autoscaler.Server, error) {
+	server := new(autoscaler.Server)
+	err := s.db.GetContext(ctx, server, s.db.Rebind("SELECT * FROM servers WHERE server_name = ?"), name)
+	return server, err
+}
+
+func (s *sqlStore) Create(ctx context.Context, server *autoscaler.Server) error {
+	if _, err := s.db.NamedExecContext(ctx, insertServerStmt, server); err != nil {
+		return err
+	}
+	s.publish(Event{Kind: EventServerCreated, Server: *server})
+	return nil
+}
+
+func (s *sqlStore) Update(ctx context.Context, server *autoscaler.Server) error {
+	_, err := s.db.NamedExecContext(ctx, updateServerStmt, server)
+	return err
+}
+
+func (s *sqlStore) Delete(ctx context.Context, server *autoscaler.Server) error {
+	if _, err := s.db.ExecContext(ctx, s.db.Rebind("DELETE FROM servers WHERE server_name = ?"), server.Name); err != nil {
+		return err
+	}
+	s.publish(Event{Kind: EventServerDestroyed, Server: *server})
+	return nil
+}
+
+func (s *sqlStore) Pause(ctx context.Context) error {
+	stmt := s.db.Rebind("UPDATE scaler_state SET state_paused = ? WHERE state_id = 1")
+	if _, err := s.db.ExecContext(ctx, stmt, true); err != nil {
+		return err
+	}
+	s.publish(Event{Kind: EventScalingPaused})
+	return nil
+}
+
+func (s *sqlStore) Resume(ctx context.Context) error {
+	stmt := s.db.Rebind("UPDATE scaler_state SET state_paused = ? WHERE state_id = 1")
+	if _, err := s.db.ExecContext(ctx, stmt, false); err != nil {
+		return err
+	}
+	s.publish(Event{Kind: EventScalingResumed})
+	return nil
+}
+
+func (s *sqlStore) Paused(ctx context.Context) (bool, error) {
+	var paused bool
+	err := s.db.GetContext(ctx, &paused, "SELECT state_paused FROM scaler_state WHERE state_id = 1")
+	return paused, err
+}
+
+// Subscribe returns a channel of lifecycle events. The channel is
+// closed when ctx is canceled.
+func (s *sqlStore) Subscribe(ctx context.Context) <-chan Event {
+	ch := make(chan Event, 16)
+
+	s.mu.Lock()
+	s.subs = append(s.subs, ch)
+	s.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		for i, sub := range s.subs {
+			if sub == ch {
+				s.subs = append(s.subs[:i], s.subs[i+1:]...)
+				break
+			}
+		}
+		close(ch)
+	}()
+
+	return ch
+}
+
+func (s *sqlStore) publish(event Event) {
+	event.Created = time.Now().Unix()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, sub := range s.subs {
+		select {
+		case sub <- event:
+		default:
+		}
+	}
+}
+
+const insertServerStmt = `
+INSERT INTO servers (server_name, server_address, server_region, server_image, server_size, server_created)
+VALUES (:server_name, :server_address, :server_region, :server_image, :server_size, :server_created)
+`
+
+const updateServerStmt = `
+UPDATE servers SET
+ server_address = :server_address
+,server_region  = :server_region
+,server_image   = :server_image
+,server_size    = :server_size
+WHERE server_name = :server_name
+`
@@ -0,0 +1,77 @@
+// Copyright 2018 Drone.IO Inc
+// Use of this software is governed by the Business Source License
+// that can be found in the LICENSE file.
+
+package store
+
+import (
+	"context"
+	"testing"
+
+	"github.com/drone/autoscaler"
+)
+
+func TestSQLStoreCreateAndList(t *testing.T) {
+	servers, err := Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+
+	ctx := context.Background()
+	in := &autoscaler.Server{
+		Name:    "agent-1",
+		Address: "10.0.0.1",
+		Region:  "nyc3",
+		Image:   "docker-20-04",
+		Size:    "s-1vcpu-1gb",
+		Created: 1234,
+	}
+	if err := servers.Create(ctx, in); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	list, err := servers.List(ctx)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(list) != 1 {
+		t.Fatalf("expected 1 server, got %d", len(list))
+	}
+	if list[0].Name != in.Name || list[0].Address != in.Address {
+		t.Fatalf("unexpected server returned: %+v", list[0])
+	}
+
+	found, err := servers.Find(ctx, "agent-1")
+	if err != nil {
+		t.Fatalf("Find: %v", err)
+	}
+	if found.Region != "nyc3" {
+		t.Fatalf("expected region nyc3, got %q", found.Region)
+	}
+}
+
+func TestSQLStorePauseResume(t *testing.T) {
+	servers, err := Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+
+	ctx := context.Background()
+	if paused, err := servers.Paused(ctx); err != nil || paused {
+		t.Fatalf("expected store to start unpaused, got paused=%v err=%v", paused, err)
+	}
+
+	if err := servers.Pause(ctx); err != nil {
+		t.Fatalf("Pause: %v", err)
+	}
+	if paused, err := servers.Paused(ctx); err != nil || !paused {
+		t.Fatalf("expected store to be paused, got paused=%v err=%v", paused, err)
+	}
+
+	if err := servers.Resume(ctx); err != nil {
+		t.Fatalf("Resume: %v", err)
+	}
+	if paused, err := servers.Paused(ctx); err != nil || paused {
+		t.Fatalf("expected store to be resumed, got paused=%v err=%v", paused, err)
+	}
+}